@@ -0,0 +1,114 @@
+package logger
+
+import (
+	"math"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// ExternalLogger is the key/value logging interface used by host
+// applications that already have their own logging pipeline (a
+// go-ethereum-style log.Logger, hclog, logr, ...). NewAdapterCore routes
+// zap output through one of these instead of duplicating output.
+type ExternalLogger interface {
+	Trace(msg string, ctx ...interface{})
+	Debug(msg string, ctx ...interface{})
+	Info(msg string, ctx ...interface{})
+	Warn(msg string, ctx ...interface{})
+	Error(msg string, ctx ...interface{})
+}
+
+// adapterCore implements zapcore.Core by forwarding entries to an
+// ExternalLogger instead of writing encoded output to a WriteSyncer.
+type adapterCore struct {
+	target ExternalLogger
+	level  zapcore.LevelEnabler
+	fields []zapcore.Field
+}
+
+// NewAdapterCore returns a zapcore.Core that forwards every entry at or
+// above lvl to target, decoding zap fields back into plain key/value
+// pairs along the way.
+func NewAdapterCore(target ExternalLogger, lvl zapcore.LevelEnabler) zapcore.Core {
+	return &adapterCore{target: target, level: lvl}
+}
+
+func (c *adapterCore) Enabled(level zapcore.Level) bool {
+	return c.level.Enabled(level)
+}
+
+func (c *adapterCore) With(fields []zapcore.Field) zapcore.Core {
+	return &adapterCore{
+		target: c.target,
+		level:  c.level,
+		fields: append(append([]zapcore.Field(nil), c.fields...), fields...),
+	}
+}
+
+func (c *adapterCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+func (c *adapterCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	all := append(append([]zapcore.Field(nil), c.fields...), fields...)
+	kv := make([]interface{}, 0, len(all)*2)
+	for _, f := range all {
+		kv = append(kv, f.Key, decodeField(f))
+	}
+
+	switch ent.Level {
+	case zapcore.DebugLevel:
+		c.target.Debug(ent.Message, kv...)
+	case zapcore.InfoLevel:
+		c.target.Info(ent.Message, kv...)
+	case zapcore.WarnLevel:
+		c.target.Warn(ent.Message, kv...)
+	case zapcore.ErrorLevel, zapcore.DPanicLevel, zapcore.PanicLevel, zapcore.FatalLevel:
+		c.target.Error(ent.Message, kv...)
+	default:
+		c.target.Trace(ent.Message, kv...)
+	}
+	return nil
+}
+
+func (c *adapterCore) Sync() error {
+	return nil
+}
+
+// decodeField turns a zapcore.Field's internal representation back into a
+// plain value, mirroring how zapcore's own encoders read it.
+func decodeField(f zapcore.Field) interface{} {
+	switch f.Type {
+	case zapcore.BoolType:
+		return f.Integer == 1
+	case zapcore.DurationType:
+		return time.Duration(f.Integer)
+	case zapcore.Float64Type:
+		return math.Float64frombits(uint64(f.Integer))
+	case zapcore.Float32Type:
+		return math.Float32frombits(uint32(f.Integer))
+	case zapcore.Int64Type, zapcore.Int32Type, zapcore.Int16Type, zapcore.Int8Type:
+		return f.Integer
+	case zapcore.Uint64Type, zapcore.Uint32Type, zapcore.Uint16Type, zapcore.Uint8Type, zapcore.UintptrType:
+		return uint64(f.Integer)
+	case zapcore.StringType:
+		return f.String
+	case zapcore.TimeType:
+		if loc, ok := f.Interface.(*time.Location); ok && loc != nil {
+			return time.Unix(0, f.Integer).In(loc)
+		}
+		return time.Unix(0, f.Integer)
+	case zapcore.TimeFullType:
+		return f.Interface
+	case zapcore.BinaryType, zapcore.ByteStringType:
+		return f.Interface
+	case zapcore.ObjectMarshalerType, zapcore.ArrayMarshalerType, zapcore.ErrorType, zapcore.StringerType, zapcore.ReflectType:
+		return f.Interface
+	default:
+		return f.Interface
+	}
+}
@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDecodeField(t *testing.T) {
+	someErr := errors.New("boom")
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	ts := time.Date(2024, 3, 1, 12, 0, 0, 0, loc)
+
+	tests := []struct {
+		name  string
+		field zapcore.Field
+		want  interface{}
+	}{
+		{"bool true", zap.Bool("k", true), true},
+		{"bool false", zap.Bool("k", false), false},
+		{"duration", zap.Duration("k", 5*time.Second), 5 * time.Second},
+		{"float64", zap.Float64("k", 3.14), 3.14},
+		{"float32", zap.Float32("k", 1.5), float32(1.5)},
+		{"int", zap.Int("k", -7), int64(-7)},
+		{"uint", zap.Uint("k", 7), uint64(7)},
+		{"string", zap.String("k", "hello"), "hello"},
+		{"error", zap.Error(someErr), someErr},
+		{"time", zap.Time("k", ts), ts},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decodeField(tt.field)
+			switch want := tt.want.(type) {
+			case time.Time:
+				gotTime, ok := got.(time.Time)
+				if !ok || !gotTime.Equal(want) {
+					t.Errorf("decodeField(%q) = %#v, want %#v", tt.name, got, want)
+				}
+			default:
+				if got != tt.want {
+					t.Errorf("decodeField(%q) = %#v, want %#v", tt.name, got, tt.want)
+				}
+			}
+		})
+	}
+
+	t.Run("float64 bit pattern", func(t *testing.T) {
+		f := zap.Float64("k", math.Pi)
+		got, ok := decodeField(f).(float64)
+		if !ok || got != math.Pi {
+			t.Errorf("decodeField(float64) = %#v, want %v", got, math.Pi)
+		}
+	})
+}
+
+type recordingExternalLogger struct {
+	level string
+	msg   string
+	ctx   []interface{}
+}
+
+func (r *recordingExternalLogger) Trace(msg string, ctx ...interface{}) { r.record("trace", msg, ctx) }
+func (r *recordingExternalLogger) Debug(msg string, ctx ...interface{}) { r.record("debug", msg, ctx) }
+func (r *recordingExternalLogger) Info(msg string, ctx ...interface{})  { r.record("info", msg, ctx) }
+func (r *recordingExternalLogger) Warn(msg string, ctx ...interface{})  { r.record("warn", msg, ctx) }
+func (r *recordingExternalLogger) Error(msg string, ctx ...interface{}) { r.record("error", msg, ctx) }
+
+func (r *recordingExternalLogger) record(level, msg string, ctx []interface{}) {
+	r.level, r.msg, r.ctx = level, msg, ctx
+}
+
+func TestAdapterCoreWritesToExternalLogger(t *testing.T) {
+	target := &recordingExternalLogger{}
+	core := NewAdapterCore(target, zapcore.InfoLevel)
+
+	logger := zap.New(core)
+	logger.Warn("disk almost full", zap.Int("percent", 95))
+
+	if target.level != "warn" {
+		t.Errorf("level = %q, want warn", target.level)
+	}
+	if target.msg != "disk almost full" {
+		t.Errorf("msg = %q", target.msg)
+	}
+	if len(target.ctx) != 2 || target.ctx[0] != "percent" || target.ctx[1] != int64(95) {
+		t.Errorf("ctx = %v, want [percent 95]", target.ctx)
+	}
+}
+
+func TestAdapterCoreRespectsLevelEnabler(t *testing.T) {
+	target := &recordingExternalLogger{}
+	core := NewAdapterCore(target, zapcore.WarnLevel)
+
+	zap.New(core).Info("should be filtered out")
+
+	if target.msg != "" {
+		t.Errorf("expected Info to be filtered out by the WarnLevel enabler, got msg=%q", target.msg)
+	}
+}
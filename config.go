@@ -0,0 +1,189 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Config controls how LogInitWithConfig builds the package logger: which
+// sinks are enabled, the level and encoding used by each, and the
+// rotation policy for the file sink.
+type Config struct {
+	ConsoleEnabled bool
+	// ConsoleLevel is this sink's own level floor, independent of
+	// FileLevel (e.g. keep console at "info" while writing "debug" to
+	// disk). SetLevel can still move entries above this floor at
+	// runtime, but never below it.
+	ConsoleLevel string
+	ConsoleJSON  bool
+
+	FileEnabled bool
+	// FileLevel is this sink's own level floor, independent of
+	// ConsoleLevel. SetLevel can still move entries above this floor at
+	// runtime, but never below it.
+	FileLevel string
+	FileJSON  bool
+	Directory string
+	Filename  string
+
+	MaxSizeMB  int
+	MaxBackups int
+	MaxAgeDays int
+	Compress   bool
+
+	// InitialFields are attached to every log entry, e.g. service/module/version.
+	InitialFields map[string]interface{}
+
+	// ExtraCores are attached alongside the console/file sinks, e.g. a
+	// core built with NewAdapterCore to route output through a host
+	// application's existing logging pipeline.
+	ExtraCores []zapcore.Core
+}
+
+// seedLevel picks the initial value for the package's dynamicLevel: the
+// more verbose of the two configured sink levels, so dynamicLevel never
+// masks a sink's own configured floor (see sinkEnabler) before SetLevel is
+// first called.
+func (cfg Config) seedLevel() zapcore.Level {
+	switch {
+	case cfg.ConsoleEnabled && cfg.FileEnabled:
+		c, f := parseLevel(cfg.ConsoleLevel), parseLevel(cfg.FileLevel)
+		if f < c {
+			return f
+		}
+		return c
+	case cfg.FileEnabled:
+		return parseLevel(cfg.FileLevel)
+	default:
+		return parseLevel(cfg.ConsoleLevel)
+	}
+}
+
+// defaultConfig mirrors the behaviour LogInit("level") has always had:
+// console + file, both at the same level, console in plain text and file
+// in JSON, logging to ./user-service.log.
+func defaultConfig(level string) Config {
+	return Config{
+		ConsoleEnabled: true,
+		ConsoleLevel:   level,
+		ConsoleJSON:    false,
+
+		FileEnabled: true,
+		FileLevel:   level,
+		FileJSON:    true,
+		Directory:   ".",
+		Filename:    "user-service.log",
+
+		MaxSizeMB:  100,
+		MaxBackups: 7,
+		MaxAgeDays: 30,
+		Compress:   true,
+	}
+}
+
+func parseLevel(level string) zapcore.Level {
+	var zapLevel zapcore.Level
+	switch level {
+	case "debug":
+		zapLevel = zapcore.DebugLevel
+	case "info":
+		zapLevel = zapcore.InfoLevel
+	case "warn":
+		zapLevel = zapcore.WarnLevel
+	case "error":
+		zapLevel = zapcore.ErrorLevel
+	case "fatal":
+		zapLevel = zapcore.FatalLevel
+	default:
+		zapLevel = zapcore.InfoLevel
+	}
+	return zapLevel
+}
+
+func baseEncoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		TimeKey:        "time",
+		LevelKey:       "level",
+		NameKey:        "logger",
+		CallerKey:      "caller",
+		MessageKey:     "message",
+		StacktraceKey:  "stacktrace",
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeLevel:    zapcore.CapitalLevelEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeName:     zapcore.FullNameEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+	}
+}
+
+func encoderFor(jsonEncoding bool, encoderConfig zapcore.EncoderConfig) zapcore.Encoder {
+	if jsonEncoding {
+		return zapcore.NewJSONEncoder(encoderConfig)
+	}
+	return zapcore.NewConsoleEncoder(encoderConfig)
+}
+
+// newLumberjackLogger builds the rotating file writer for cfg's file
+// sink, broken out so its wiring (Directory/Filename and the rotation
+// knobs) can be asserted on directly in tests.
+func newLumberjackLogger(cfg Config) *lumberjack.Logger {
+	return &lumberjack.Logger{
+		Filename:   filepath.Join(cfg.Directory, cfg.Filename),
+		MaxSize:    cfg.MaxSizeMB,
+		MaxBackups: cfg.MaxBackups,
+		MaxAge:     cfg.MaxAgeDays,
+		Compress:   cfg.Compress,
+	}
+}
+
+// LogInitWithConfig builds the package logger from cfg. Unlike LogInit it
+// is not guarded by a sync.Once, so callers that need a non-default setup
+// (tests, alternate rotation policy, extra initial fields) can call it
+// directly instead of going through the "debug"/"info"/... shorthand.
+func LogInitWithConfig(cfg Config) {
+	dynamicLevel.SetLevel(cfg.seedLevel())
+
+	encoderConfig := baseEncoderConfig()
+
+	var cores []namedCore
+
+	if cfg.ConsoleEnabled {
+		consoleWriteSyncer := zapcore.AddSync(os.Stdout)
+		cores = append(cores, namedCore{name: "console", core: zapcore.NewCore(
+			encoderFor(cfg.ConsoleJSON, encoderConfig),
+			consoleWriteSyncer,
+			sinkEnabler(parseLevel(cfg.ConsoleLevel)),
+		)})
+	}
+
+	if cfg.FileEnabled {
+		fileWriteSyncer := zapcore.AddSync(newLumberjackLogger(cfg))
+		cores = append(cores, namedCore{name: "file", core: zapcore.NewCore(
+			encoderFor(cfg.FileJSON, encoderConfig),
+			fileWriteSyncer,
+			sinkEnabler(parseLevel(cfg.FileLevel)),
+		)})
+	}
+
+	for _, extra := range cfg.ExtraCores {
+		cores = append(cores, namedCore{core: extra})
+	}
+
+	resetCores(cores...)
+
+	opts := []zap.Option{zap.AddCaller(), zap.AddStacktrace(zapcore.ErrorLevel)}
+	if len(cfg.InitialFields) > 0 {
+		fields := make([]zap.Field, 0, len(cfg.InitialFields))
+		for k, v := range cfg.InitialFields {
+			fields = append(fields, zap.Any(k, v))
+		}
+		opts = append(opts, zap.Fields(fields...))
+	}
+
+	logger = zap.New(root, opts...)
+}
@@ -0,0 +1,186 @@
+package logger
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNewLumberjackLoggerWiresRotationFields(t *testing.T) {
+	cfg := Config{
+		Directory:  "/var/log/myapp",
+		Filename:   "app.log",
+		MaxSizeMB:  50,
+		MaxBackups: 3,
+		MaxAgeDays: 14,
+		Compress:   true,
+	}
+
+	lj := newLumberjackLogger(cfg)
+
+	wantFilename := filepath.Join(cfg.Directory, cfg.Filename)
+	if lj.Filename != wantFilename {
+		t.Errorf("Filename = %q, want %q", lj.Filename, wantFilename)
+	}
+	if lj.MaxSize != cfg.MaxSizeMB {
+		t.Errorf("MaxSize = %d, want %d", lj.MaxSize, cfg.MaxSizeMB)
+	}
+	if lj.MaxBackups != cfg.MaxBackups {
+		t.Errorf("MaxBackups = %d, want %d", lj.MaxBackups, cfg.MaxBackups)
+	}
+	if lj.MaxAge != cfg.MaxAgeDays {
+		t.Errorf("MaxAge = %d, want %d", lj.MaxAge, cfg.MaxAgeDays)
+	}
+	if lj.Compress != cfg.Compress {
+		t.Errorf("Compress = %v, want %v", lj.Compress, cfg.Compress)
+	}
+}
+
+func TestSeedLevelPicksMoreVerboseSink(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want zapcore.Level
+	}{
+		{
+			name: "console more verbose",
+			cfg:  Config{ConsoleEnabled: true, ConsoleLevel: "debug", FileEnabled: true, FileLevel: "info"},
+			want: zapcore.DebugLevel,
+		},
+		{
+			name: "file more verbose",
+			cfg:  Config{ConsoleEnabled: true, ConsoleLevel: "info", FileEnabled: true, FileLevel: "debug"},
+			want: zapcore.DebugLevel,
+		},
+		{
+			name: "only file enabled",
+			cfg:  Config{FileEnabled: true, FileLevel: "warn"},
+			want: zapcore.WarnLevel,
+		},
+		{
+			name: "only console enabled",
+			cfg:  Config{ConsoleEnabled: true, ConsoleLevel: "error"},
+			want: zapcore.ErrorLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cfg.seedLevel(); got != tt.want {
+				t.Errorf("seedLevel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncoderForSelectsJSONOrConsole(t *testing.T) {
+	encoderConfig := baseEncoderConfig()
+	entry := zapcore.Entry{Message: "hi", Level: zapcore.InfoLevel}
+
+	jsonBuf, err := encoderFor(true, encoderConfig).EncodeEntry(entry, nil)
+	if err != nil {
+		t.Fatalf("EncodeEntry (json): %v", err)
+	}
+	if got := jsonBuf.String(); !strings.HasPrefix(strings.TrimSpace(got), "{") {
+		t.Errorf("json encoder output = %q, want it to start with '{'", got)
+	}
+
+	consoleBuf, err := encoderFor(false, encoderConfig).EncodeEntry(entry, nil)
+	if err != nil {
+		t.Fatalf("EncodeEntry (console): %v", err)
+	}
+	if got := consoleBuf.String(); strings.HasPrefix(strings.TrimSpace(got), "{") {
+		t.Errorf("console encoder output = %q, want plain text, not JSON", got)
+	}
+}
+
+func TestLogInitWithConfigWritesRotatingJSONFile(t *testing.T) {
+	dir := t.TempDir()
+
+	LogInitWithConfig(Config{
+		FileEnabled: true,
+		FileLevel:   "info",
+		FileJSON:    true,
+		Directory:   dir,
+		Filename:    "test.log",
+		MaxSizeMB:   1,
+		MaxBackups:  1,
+		MaxAgeDays:  1,
+	})
+
+	GetLogger().Info("hello from config test")
+	if err := GetLogger().Sync(); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	path := filepath.Join(dir, "test.log")
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("expected file sink to create %s: %v", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	found := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.Contains(line, "hello from config test") {
+			found = true
+			if !strings.HasPrefix(line, "{") {
+				t.Errorf("expected a JSON line since FileJSON is true, got %q", line)
+			}
+		}
+	}
+	if !found {
+		t.Error("did not find the logged message in the rotated file")
+	}
+}
+
+func TestLogInitWithConfigAppliesInitialFields(t *testing.T) {
+	dir := t.TempDir()
+
+	LogInitWithConfig(Config{
+		FileEnabled: true,
+		FileLevel:   "info",
+		FileJSON:    true,
+		Directory:   dir,
+		Filename:    "test.log",
+		InitialFields: map[string]interface{}{
+			"service": "order-service",
+		},
+	})
+
+	GetLogger().Info("tagged entry")
+	if err := GetLogger().Sync(); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "test.log"))
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if !strings.Contains(string(data), `"service":"order-service"`) {
+		t.Errorf("expected InitialFields to be attached to every entry, got: %s", data)
+	}
+}
+
+func TestDefaultConfigMatchesHistoricalLogInitBehaviour(t *testing.T) {
+	cfg := defaultConfig("debug")
+
+	if !cfg.ConsoleEnabled || cfg.ConsoleJSON {
+		t.Errorf("console should be enabled and plain-text, got enabled=%v json=%v", cfg.ConsoleEnabled, cfg.ConsoleJSON)
+	}
+	if !cfg.FileEnabled || !cfg.FileJSON {
+		t.Errorf("file should be enabled and JSON, got enabled=%v json=%v", cfg.FileEnabled, cfg.FileJSON)
+	}
+	if cfg.ConsoleLevel != "debug" || cfg.FileLevel != "debug" {
+		t.Errorf("both sinks should seed from the requested level, got console=%q file=%q", cfg.ConsoleLevel, cfg.FileLevel)
+	}
+	if filepath.Join(cfg.Directory, cfg.Filename) != "user-service.log" {
+		t.Errorf("expected the historical ./user-service.log path, got %q", filepath.Join(cfg.Directory, cfg.Filename))
+	}
+}
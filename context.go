@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type loggerCtxKey struct{}
+type fieldsCtxKey struct{}
+
+// Bind returns a copy of ctx carrying l, retrievable via FromContext and
+// Ctx. It lets middleware (see the ginzap subpackage) push a
+// pre-enriched logger down the call stack.
+func Bind(ctx context.Context, l *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, l)
+}
+
+// FromContext returns the logger previously stashed in ctx via Bind,
+// falling back to the package logger if none is present.
+func FromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(loggerCtxKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return GetLogger()
+}
+
+// With returns a copy of ctx carrying fields in addition to any already
+// attached by an earlier With call. Ctx(ctx) attaches them to every entry
+// it logs.
+func With(ctx context.Context, fields ...zap.Field) context.Context {
+	if existing, ok := ctx.Value(fieldsCtxKey{}).([]zap.Field); ok {
+		fields = append(append([]zap.Field(nil), existing...), fields...)
+	}
+	return context.WithValue(ctx, fieldsCtxKey{}, fields)
+}
+
+// Ctx returns a logger for ctx: the one bound via Bind (or the package
+// logger otherwise), enriched with the OpenTelemetry trace_id/span_id
+// carried by ctx and any fields attached via With. This gives one
+// request = one query log correlation, e.g.:
+//
+//	logger.Ctx(ctx).Info("order placed", zap.String("order_id", id))
+func Ctx(ctx context.Context) *zap.Logger {
+	l := FromContext(ctx)
+
+	var fields []zap.Field
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", span.TraceID().String()),
+			zap.String("span_id", span.SpanID().String()),
+		)
+	}
+	if extra, ok := ctx.Value(fieldsCtxKey{}).([]zap.Field); ok {
+		fields = append(fields, extra...)
+	}
+
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields...)
+}
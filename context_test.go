@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestCtxFallsBackToPackageLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	resetCores(namedCore{name: "test", core: core})
+	logger = zap.New(root)
+
+	Ctx(context.Background()).Info("no bound logger")
+
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("got %d entries, want 1", got)
+	}
+}
+
+func TestCtxUsesBoundLogger(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	bound := zap.New(core)
+
+	ctx := Bind(context.Background(), bound)
+	Ctx(ctx).Info("bound")
+
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("got %d entries, want 1", got)
+	}
+	if got := FromContext(ctx); got != bound {
+		t.Errorf("FromContext returned a different logger than the one bound")
+	}
+}
+
+func TestCtxMergesWithFields(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	bound := zap.New(core)
+	ctx := Bind(context.Background(), bound)
+	ctx = With(ctx, zap.String("request_id", "abc"))
+	ctx = With(ctx, zap.String("user_id", "42"))
+
+	Ctx(ctx).Info("merged")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["request_id"] != "abc" {
+		t.Errorf("request_id = %v, want abc", fields["request_id"])
+	}
+	if fields["user_id"] != "42" {
+		t.Errorf("user_id = %v, want 42", fields["user_id"])
+	}
+}
+
+func TestCtxAttachesTraceAndSpanID(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	bound := zap.New(core)
+	ctx := Bind(context.Background(), bound)
+
+	traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+	spanID, _ := trace.SpanIDFromHex("0102030405060708")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx = trace.ContextWithSpanContext(ctx, sc)
+
+	Ctx(ctx).Info("traced")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["trace_id"] != traceID.String() {
+		t.Errorf("trace_id = %v, want %v", fields["trace_id"], traceID.String())
+	}
+	if fields["span_id"] != spanID.String() {
+		t.Errorf("span_id = %v, want %v", fields["span_id"], spanID.String())
+	}
+}
@@ -0,0 +1,137 @@
+package logger
+
+import (
+	"sync"
+
+	"go.uber.org/multierr"
+	"go.uber.org/zap/zapcore"
+)
+
+// namedCore pairs a zapcore.Core with the identifiers root uses to find it
+// again: id for cores added anonymously via AddCore, name for cores that
+// should be addressable later via ReplaceCore.
+type namedCore struct {
+	id   uint64
+	name string
+	core zapcore.Core
+}
+
+// lockedMultiCore is a zapcore.Core that fans entries out to a dynamic set
+// of child cores, guarded by an RWMutex so cores can be added, replaced or
+// removed while the logger is in use.
+type lockedMultiCore struct {
+	mu     sync.RWMutex
+	nextID uint64
+	cores  []namedCore
+}
+
+// root is the core every logger built by LogInitWithConfig is wrapped
+// around, letting AddCore/ReplaceCore attach extra sinks (an in-memory
+// ring buffer for tests, a Sentry/OTLP exporter, a per-request tee, ...)
+// without rebuilding the logger.
+var root = &lockedMultiCore{}
+
+// resetCores replaces root's children wholesale with the named cores
+// LogInitWithConfig just built, dropping anything previously attached via
+// AddCore/ReplaceCore.
+func resetCores(named ...namedCore) {
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	root.cores = named
+	root.nextID = uint64(len(named))
+}
+
+func (mc *lockedMultiCore) With(fields []zapcore.Field) zapcore.Core {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	cloned := &lockedMultiCore{nextID: mc.nextID}
+	cloned.cores = make([]namedCore, len(mc.cores))
+	for i, nc := range mc.cores {
+		cloned.cores[i] = namedCore{id: nc.id, name: nc.name, core: nc.core.With(fields)}
+	}
+	return cloned
+}
+
+func (mc *lockedMultiCore) Enabled(lvl zapcore.Level) bool {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	for _, nc := range mc.cores {
+		if nc.core.Enabled(lvl) {
+			return true
+		}
+	}
+	return false
+}
+
+func (mc *lockedMultiCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	for _, nc := range mc.cores {
+		if nc.core.Enabled(ent.Level) {
+			ce = nc.core.Check(ent, ce)
+		}
+	}
+	return ce
+}
+
+func (mc *lockedMultiCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	var err error
+	for _, nc := range mc.cores {
+		err = multierr.Append(err, nc.core.Write(ent, fields))
+	}
+	return err
+}
+
+func (mc *lockedMultiCore) Sync() error {
+	mc.mu.RLock()
+	defer mc.mu.RUnlock()
+	var err error
+	for _, nc := range mc.cores {
+		err = multierr.Append(err, nc.core.Sync())
+	}
+	return err
+}
+
+// AddCore attaches an extra core to the root multi-core so entries start
+// fanning out to it too, without rebuilding the logger. The returned
+// removeFn detaches it again; calling it more than once is a no-op.
+func AddCore(core zapcore.Core) (removeFn func()) {
+	root.mu.Lock()
+	id := root.nextID
+	root.nextID++
+	root.cores = append(root.cores, namedCore{id: id, core: core})
+	root.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			root.mu.Lock()
+			defer root.mu.Unlock()
+			for i, nc := range root.cores {
+				if nc.id == id {
+					root.cores = append(root.cores[:i], root.cores[i+1:]...)
+					return
+				}
+			}
+		})
+	}
+}
+
+// ReplaceCore swaps the core registered under name, or attaches core under
+// that name if nothing is registered yet. Built-in sinks are registered as
+// "console" and "file", so e.g. ReplaceCore("file", newFileCore) can swap
+// the file sink without touching the console one.
+func ReplaceCore(name string, core zapcore.Core) {
+	root.mu.Lock()
+	defer root.mu.Unlock()
+	for i, nc := range root.cores {
+		if nc.name == name {
+			root.cores[i].core = core
+			return
+		}
+	}
+	root.cores = append(root.cores, namedCore{id: root.nextID, name: name, core: core})
+	root.nextID++
+}
@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type failingCore struct {
+	zapcore.LevelEnabler
+	syncErr error
+}
+
+func (f *failingCore) With([]zapcore.Field) zapcore.Core { return f }
+
+func (f *failingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return ce.AddCore(ent, f)
+}
+
+func (f *failingCore) Write(zapcore.Entry, []zapcore.Field) error { return nil }
+
+func (f *failingCore) Sync() error { return f.syncErr }
+
+func TestLockedMultiCoreFansOutWrites(t *testing.T) {
+	coreA, logsA := observer.New(zapcore.InfoLevel)
+	coreB, logsB := observer.New(zapcore.InfoLevel)
+	resetCores(namedCore{name: "a", core: coreA}, namedCore{name: "b", core: coreB})
+
+	zap.New(root).Info("hello")
+
+	if got := logsA.Len(); got != 1 {
+		t.Errorf("core a: got %d entries, want 1", got)
+	}
+	if got := logsB.Len(); got != 1 {
+		t.Errorf("core b: got %d entries, want 1", got)
+	}
+}
+
+func TestLockedMultiCoreSyncAggregatesErrors(t *testing.T) {
+	errA := errors.New("sync a failed")
+	errB := errors.New("sync b failed")
+	resetCores(
+		namedCore{name: "a", core: &failingCore{LevelEnabler: zapcore.InfoLevel, syncErr: errA}},
+		namedCore{name: "b", core: &failingCore{LevelEnabler: zapcore.InfoLevel, syncErr: errB}},
+	)
+
+	err := root.Sync()
+	if !errors.Is(err, errA) {
+		t.Errorf("Sync() error does not wrap errA: %v", err)
+	}
+	if !errors.Is(err, errB) {
+		t.Errorf("Sync() error does not wrap errB: %v", err)
+	}
+}
+
+func TestAddCoreAndRemove(t *testing.T) {
+	resetCores()
+
+	extra, logs := observer.New(zapcore.InfoLevel)
+	remove := AddCore(extra)
+
+	l := zap.New(root)
+	l.Info("one")
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("after AddCore: got %d entries, want 1", got)
+	}
+
+	remove()
+	l.Info("two")
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("after remove: got %d entries, want still 1", got)
+	}
+
+	// Calling the returned remover twice must be a no-op, not a panic.
+	remove()
+}
+
+func TestReplaceCoreSwapsByName(t *testing.T) {
+	first, firstLogs := observer.New(zapcore.InfoLevel)
+	resetCores(namedCore{name: "console", core: first})
+
+	second, secondLogs := observer.New(zapcore.InfoLevel)
+	ReplaceCore("console", second)
+
+	zap.New(root).Info("replaced")
+
+	if got := firstLogs.Len(); got != 0 {
+		t.Errorf("replaced core: got %d entries, want 0", got)
+	}
+	if got := secondLogs.Len(); got != 1 {
+		t.Errorf("new core: got %d entries, want 1", got)
+	}
+}
+
+func TestReplaceCoreAttachesWhenNameUnknown(t *testing.T) {
+	resetCores()
+
+	core, logs := observer.New(zapcore.InfoLevel)
+	ReplaceCore("file", core)
+
+	zap.New(root).Info("attached")
+
+	if got := logs.Len(); got != 1 {
+		t.Fatalf("got %d entries, want 1", got)
+	}
+}
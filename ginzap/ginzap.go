@@ -0,0 +1,113 @@
+// Package ginzap wires the logger package into gin, replacing gin's
+// default Logger/Recovery middleware with structured zap logging that is
+// correlated per request via request_id.
+package ginzap
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/wazwki/logger"
+)
+
+const requestIDHeader = "X-Request-ID"
+
+// noAutoStacktrace is passed to zap.AddStacktrace to disable the
+// logger's automatic per-call stack capture: it's a level no entry can
+// ever reach, so Recovery's explicit zap.Stack field (see below) is the
+// only stack trace emitted, instead of being duplicated by the package
+// logger's always-on zap.AddStacktrace(zapcore.ErrorLevel).
+const noAutoStacktrace = zapcore.Level(math.MaxInt8)
+
+// Logger returns a gin.HandlerFunc that replaces gin's built-in Logger().
+// It derives a request-scoped *zap.Logger tagged with a request_id (read
+// from X-Request-ID or generated when absent), stashes it on the request
+// context so logger.FromContext(ctx) can retrieve it downstream, and logs
+// one structured entry per request. Paths in skipPaths are not logged.
+func Logger(skipPaths ...string) gin.HandlerFunc {
+	skip := make(map[string]struct{}, len(skipPaths))
+	for _, p := range skipPaths {
+		skip[p] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		start := time.Now()
+		path := c.Request.URL.Path
+		query := c.Request.URL.RawQuery
+
+		requestID := c.GetHeader(requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		reqLogger := logger.GetLogger().With(zap.String("request_id", requestID))
+		c.Request = c.Request.WithContext(logger.Bind(c.Request.Context(), reqLogger))
+
+		c.Next()
+
+		if _, skipped := skip[path]; skipped {
+			return
+		}
+
+		status := c.Writer.Status()
+		fields := []zap.Field{
+			zap.Int("status", status),
+			zap.String("method", c.Request.Method),
+			zap.String("path", path),
+			zap.String("query", query),
+			zap.String("ip", c.ClientIP()),
+			zap.String("user-agent", c.Request.UserAgent()),
+			zap.Duration("latency", time.Since(start)),
+		}
+		if len(c.Errors) > 0 {
+			fields = append(fields, zap.String("errors", c.Errors.String()))
+		}
+
+		switch {
+		case status >= http.StatusInternalServerError:
+			reqLogger.Error(path, fields...)
+		case status >= http.StatusBadRequest:
+			reqLogger.Warn(path, fields...)
+		default:
+			reqLogger.Info(path, fields...)
+		}
+	}
+}
+
+// Recovery returns a gin.HandlerFunc that replaces gin's built-in
+// Recovery(). It logs the panic via the request-scoped logger, including
+// a stack trace when stack is true, and aborts the request with 500.
+func Recovery(stack bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+
+			fields := []zap.Field{
+				zap.Any("error", rec),
+				zap.String("method", c.Request.Method),
+				zap.String("path", c.Request.URL.Path),
+			}
+			if stack {
+				fields = append(fields, zap.Stack("stacktrace"))
+			}
+
+			// Disable the package logger's own automatic stacktrace
+			// capture here so stack controls the only stacktrace field
+			// that can appear, rather than the two of them colliding
+			// under the same "stacktrace" key.
+			l := logger.FromContext(c.Request.Context()).WithOptions(zap.AddStacktrace(noAutoStacktrace))
+			l.Error("recovered from panic", fields...)
+			c.AbortWithStatus(http.StatusInternalServerError)
+		}()
+		c.Next()
+	}
+}
@@ -0,0 +1,157 @@
+package ginzap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/wazwki/logger"
+)
+
+// newObservedRouter wires logger's package logger to an observer core
+// only (no console/file sinks) so assertions can inspect exactly what
+// the middleware logged.
+func newObservedRouter(t *testing.T) (*gin.Engine, *observer.ObservedLogs) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	logger.LogInitWithConfig(logger.Config{})
+	core, logs := observer.New(zapcore.InfoLevel)
+	t.Cleanup(logger.AddCore(core))
+
+	r := gin.New()
+	r.Use(Logger(), Recovery(false))
+	return r, logs
+}
+
+func TestLoggerAttachesRequestScopedLoggerToContext(t *testing.T) {
+	r, logs := newObservedRouter(t)
+
+	var got *zap.Logger
+	r.GET("/ping", func(c *gin.Context) {
+		got = logger.FromContext(c.Request.Context())
+		c.String(http.StatusOK, "pong")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if got == nil {
+		t.Fatal("handler did not observe a logger on the request context")
+	}
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["request_id"] != "req-123" {
+		t.Errorf("request_id = %v, want req-123", fields["request_id"])
+	}
+	if fields["status"] != int64(http.StatusOK) {
+		t.Errorf("status field = %v, want 200", fields["status"])
+	}
+}
+
+func TestLoggerSkipsConfiguredPaths(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	logger.LogInitWithConfig(logger.Config{})
+	core, logs := observer.New(zapcore.InfoLevel)
+	t.Cleanup(logger.AddCore(core))
+
+	r := gin.New()
+	r.Use(Logger("/health"))
+	r.GET("/health", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if got := logs.Len(); got != 0 {
+		t.Errorf("got %d entries for a skipped path, want 0", got)
+	}
+}
+
+func TestRecoveryCatchesPanicAndReturns500(t *testing.T) {
+	r, logs := newObservedRouter(t)
+	r.GET("/boom", func(c *gin.Context) {
+		panic("kaboom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/boom", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	if w.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500", w.Code)
+	}
+
+	found := false
+	for _, e := range logs.All() {
+		if e.Message == "recovered from panic" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error(`expected a "recovered from panic" log entry`)
+	}
+}
+
+func countStacktraceFields(fields []zapcore.Field) int {
+	n := 0
+	for _, f := range fields {
+		if f.Key == "stacktrace" {
+			n++
+		}
+	}
+	return n
+}
+
+func recoverOnce(t *testing.T, stack bool) []zapcore.Field {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	logger.LogInitWithConfig(logger.Config{})
+	core, logs := observer.New(zapcore.InfoLevel)
+	t.Cleanup(logger.AddCore(core))
+
+	r := gin.New()
+	r.Use(Recovery(stack))
+	r.GET("/boom", func(c *gin.Context) { panic("kaboom") })
+
+	req := httptest.NewRequest(http.MethodGet, "/boom", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d log entries, want 1", len(entries))
+	}
+	if entries[0].Stack != "" {
+		t.Errorf("entry also carries the package logger's automatic stacktrace: %q", entries[0].Stack)
+	}
+	return entries[0].Context
+}
+
+func TestRecoveryWithStackAddsExactlyOneStacktraceField(t *testing.T) {
+	fields := recoverOnce(t, true)
+	if got := countStacktraceFields(fields); got != 1 {
+		t.Errorf(`got %d "stacktrace" fields with Recovery(true), want exactly 1 (no duplicate from the package logger's automatic capture)`, got)
+	}
+}
+
+func TestRecoveryWithoutStackAddsNoStacktraceField(t *testing.T) {
+	fields := recoverOnce(t, false)
+	if got := countStacktraceFields(fields); got != 0 {
+		t.Errorf(`got %d "stacktrace" fields with Recovery(false), want 0`, got)
+	}
+}
@@ -0,0 +1,47 @@
+package logger
+
+import (
+	"net/http"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// dynamicLevel is the package-global, runtime-adjustable log level that
+// gates every core built by LogInitWithConfig. It replaces the level that
+// used to be frozen inside once.Do: operators can flip it via SetLevel or
+// the handler returned by LevelHandler without restarting the process.
+var dynamicLevel = zap.NewAtomicLevel()
+
+// SetLevel changes the active log level at runtime. It accepts the same
+// strings as LogInit ("debug", "info", "warn", "error", "fatal").
+func SetLevel(level string) error {
+	parsed, err := zapcore.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	dynamicLevel.SetLevel(parsed)
+	return nil
+}
+
+// Level returns the log level currently in effect.
+func Level() zapcore.Level {
+	return dynamicLevel.Level()
+}
+
+// LevelHandler returns an http.Handler, suitable for mounting at e.g.
+// /debug/log/level, that supports GET to read and PUT to change the
+// current level using the same JSON shape as zap.AtomicLevel.ServeHTTP.
+func LevelHandler() http.Handler {
+	return dynamicLevel
+}
+
+// sinkEnabler gates a sink on both its own configured floor and the
+// shared dynamicLevel, so each sink keeps the independent level it was
+// configured with (e.g. console at info, file at debug) while SetLevel
+// still adjusts every sink at runtime within that floor.
+func sinkEnabler(floor zapcore.Level) zapcore.LevelEnabler {
+	return zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+		return lvl >= floor && dynamicLevel.Enabled(lvl)
+	})
+}
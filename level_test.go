@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestSetLevelAndLevelRoundTrip(t *testing.T) {
+	t.Cleanup(func() { _ = SetLevel("info") })
+
+	if err := SetLevel("warn"); err != nil {
+		t.Fatalf("SetLevel: %v", err)
+	}
+	if got := Level(); got != zapcore.WarnLevel {
+		t.Errorf("Level() = %v, want %v", got, zapcore.WarnLevel)
+	}
+}
+
+func TestSetLevelRejectsUnknownLevel(t *testing.T) {
+	if err := SetLevel("not-a-level"); err == nil {
+		t.Error("expected an error for an invalid level string, got nil")
+	}
+}
+
+func TestLevelHandlerGet(t *testing.T) {
+	t.Cleanup(func() { _ = SetLevel("info") })
+	if err := SetLevel("warn"); err != nil {
+		t.Fatalf("SetLevel: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/log/level", nil)
+	w := httptest.NewRecorder()
+	LevelHandler().ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if got := w.Body.String(); !strings.Contains(got, `"level":"warn"`) {
+		t.Errorf("body = %q, want it to contain level=warn", got)
+	}
+}
+
+func TestLevelHandlerPut(t *testing.T) {
+	t.Cleanup(func() { _ = SetLevel("info") })
+
+	req := httptest.NewRequest(http.MethodPut, "/debug/log/level", strings.NewReader(`{"level":"error"}`))
+	w := httptest.NewRecorder()
+	LevelHandler().ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", w.Code)
+	}
+	if got := Level(); got != zapcore.ErrorLevel {
+		t.Errorf("Level() after PUT = %v, want %v", got, zapcore.ErrorLevel)
+	}
+}
+
+// TestSinkEnablerKeepsIndependentPerSinkFloor pins down the regression
+// fixed in f6ea067: a shared dynamicLevel must never let SetLevel reveal
+// entries below a sink's own configured floor.
+func TestSinkEnablerKeepsIndependentPerSinkFloor(t *testing.T) {
+	t.Cleanup(func() { _ = SetLevel("info") })
+
+	consoleCore, consoleLogs := observer.New(sinkEnabler(zapcore.InfoLevel))
+	fileCore, fileLogs := observer.New(sinkEnabler(zapcore.DebugLevel))
+	resetCores(
+		namedCore{name: "console", core: consoleCore},
+		namedCore{name: "file", core: fileCore},
+	)
+
+	if err := SetLevel("debug"); err != nil {
+		t.Fatalf("SetLevel: %v", err)
+	}
+
+	zap.New(root).Debug("debug line")
+
+	if got := consoleLogs.Len(); got != 0 {
+		t.Errorf("console (floor=info) got %d entries after SetLevel(debug), want 0", got)
+	}
+	if got := fileLogs.Len(); got != 1 {
+		t.Errorf("file (floor=debug) got %d entries after SetLevel(debug), want 1", got)
+	}
+}